@@ -0,0 +1,329 @@
+/*
+Copyright (c) 2022 Maxim Konakov
+All rights reserved.
+
+Redistribution and use in source and binary forms, with or without modification,
+are permitted provided that the following conditions are met:
+
+1. Redistributions of source code must retain the above copyright notice,
+   this list of conditions and the following disclaimer.
+2. Redistributions in binary form must reproduce the above copyright notice,
+   this list of conditions and the following disclaimer in the documentation
+   and/or other materials provided with the distribution.
+3. Neither the name of the copyright holder nor the names of its contributors
+   may be used to endorse or promote products derived from this software without
+   specific prior written permission.
+
+THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED.
+IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT,
+INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING
+NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE,
+EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+*/
+
+package vald
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// checkers is the registry of named Checker functions that struct tags processed by
+// Bind can refer to via "check=<name>".
+var checkers = map[string]Checker{
+	"bool": Bool,
+}
+
+// RegisterChecker registers the given Checker under the given name, making it
+// available to Bind via the "check=<name>" struct tag element. Registering under a
+// name that is already in use replaces the existing entry.
+func RegisterChecker(name string, c Checker) {
+	checkers[name] = c
+}
+
+// checkerFactories is the registry of named Checker constructors that struct tags
+// processed by Bind can refer to via "check=<name>:<args>", e.g. "check=int:1..120".
+var checkerFactories = map[string]func(args string) (Checker, error){
+	"int":   intRangeFactory,
+	"uint":  uintRangeFactory,
+	"float": floatRangeFactory,
+}
+
+// RegisterCheckerFactory registers the given Checker constructor under the given
+// name, making it available to Bind via the "check=<name>:<args>" struct tag
+// element, with everything after the colon passed to f as args. Registering under
+// a name that is already in use replaces the existing entry.
+func RegisterCheckerFactory(name string, f func(args string) (Checker, error)) {
+	checkerFactories[name] = f
+}
+
+// splitRange splits a "<min>..<max>" args string into its two bounds.
+func splitRange(args string) (lo, hi string, err error) {
+	lo, hi, ok := strings.Cut(args, "..")
+
+	if !ok {
+		return "", "", errors.New("invalid range: " + strconv.Quote(args))
+	}
+
+	return lo, hi, nil
+}
+
+func intRangeFactory(args string) (Checker, error) {
+	lo, hi, err := splitRange(args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	min, err := strconv.ParseInt(lo, 10, 64)
+
+	if err != nil {
+		return nil, mapNumErr(lo, err)
+	}
+
+	max, err := strconv.ParseInt(hi, 10, 64)
+
+	if err != nil {
+		return nil, mapNumErr(hi, err)
+	}
+
+	return Int(min, max), nil
+}
+
+func uintRangeFactory(args string) (Checker, error) {
+	lo, hi, err := splitRange(args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	min, err := strconv.ParseUint(lo, 10, 64)
+
+	if err != nil {
+		return nil, mapNumErr(lo, err)
+	}
+
+	max, err := strconv.ParseUint(hi, 10, 64)
+
+	if err != nil {
+		return nil, mapNumErr(hi, err)
+	}
+
+	return Uint(min, max), nil
+}
+
+func floatRangeFactory(args string) (Checker, error) {
+	lo, hi, err := splitRange(args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	min, err := strconv.ParseFloat(lo, 64)
+
+	if err != nil {
+		return nil, mapNumErr(lo, err)
+	}
+
+	max, err := strconv.ParseFloat(hi, 64)
+
+	if err != nil {
+		return nil, mapNumErr(hi, err)
+	}
+
+	return Float(min, max), nil
+}
+
+// resolveChecker looks up the Checker named by a "check=<name>" or
+// "check=<name>:<args>" tag element, in checkerFactories when args are
+// present, otherwise in checkers.
+func resolveChecker(spec string) (Checker, error) {
+	name, args, hasArgs := strings.Cut(spec, ":")
+
+	if hasArgs {
+		factory, ok := checkerFactories[name]
+
+		if !ok {
+			return nil, errors.New("unknown checker: " + strconv.Quote(spec))
+		}
+
+		return factory(args)
+	}
+
+	if check, ok := checkers[name]; ok {
+		return check, nil
+	}
+
+	return nil, errors.New("unknown checker: " + strconv.Quote(spec))
+}
+
+// Bind populates the exported fields of the struct pointed to by out, reading values
+// through get and validating them according to each field's `vald` struct tag, e.g.,
+// with an "email" checker first registered via RegisterChecker("email", is.Email):
+//
+//	type Form struct {
+//		Email string `vald:"key=email,required,check=email"`
+//		Age   string `vald:"key=age,check=int:1..120"`
+//	}
+//
+// The tag is a comma-separated list of elements:
+//   - key=<name>         the Getter key to read; defaults to the Go field name
+//   - required           the key must be present, otherwise it is an error
+//   - check=<name>       a Checker registered with RegisterChecker, used to
+//     validate and canonicalize the value before it is assigned to the field;
+//     "bool" is the only name pre-registered this way
+//   - check=<name>:<args> a Checker built by a factory registered with
+//     RegisterCheckerFactory, with everything after the colon passed as args;
+//     "int", "uint" and "float" are pre-registered this way, taking a
+//     "<min>..<max>" range, e.g. "check=int:1..120"
+//
+// Fields without a `vald` tag, and unexported fields, are left untouched. Supported
+// field types are string, bool, the integer and float kinds, and []string, the
+// latter populated by splitting the checked value on commas.
+//
+// All per-field failures - missing required keys, unknown checker names, and values
+// that cannot be converted to the field's type - are collected into an Errors value
+// rather than stopping at the first one.
+func Bind(get Getter, out any) error {
+	v := reflect.ValueOf(out)
+
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		panic("vald.Bind() expects a non-nil pointer to a struct")
+	}
+
+	v = v.Elem()
+	t := v.Type()
+	errs := make(Errors)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag, ok := field.Tag.Lookup("vald")
+
+		if !ok || !field.IsExported() {
+			continue
+		}
+
+		key, required, checkName := parseBindTag(tag, field.Name)
+		val := get(key)
+
+		if len(val) == 0 {
+			if required {
+				errs[key] = errors.New("parameter " + strconv.Quote(key) + ": missing value")
+			}
+
+			continue
+		}
+
+		if checkName != "" {
+			check, err := resolveChecker(checkName)
+
+			if err != nil {
+				errs[key] = err
+				continue
+			}
+
+			checked, err := check(val)
+
+			if err != nil {
+				errs[key] = errors.New("parameter " + strconv.Quote(key) + ": " + err.Error())
+				continue
+			}
+
+			val = checked
+		}
+
+		if err := setField(v.Field(i), val); err != nil {
+			errs[key] = err
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+// parseBindTag splits a `vald` struct tag into its key, required flag, and checker
+// name, defaulting the key to fieldName when no "key=" element is present.
+func parseBindTag(tag, fieldName string) (key string, required bool, check string) {
+	key = fieldName
+
+	for _, part := range strings.Split(tag, ",") {
+		switch part = strings.TrimSpace(part); {
+		case part == "required":
+			required = true
+		case strings.HasPrefix(part, "key="):
+			key = part[len("key="):]
+		case strings.HasPrefix(part, "check="):
+			check = part[len("check="):]
+		}
+	}
+
+	return
+}
+
+// setField converts val to the type of field and assigns it, or returns an error
+// if field's type is not one Bind supports.
+func setField(field reflect.Value, val string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(val)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(val)
+
+		if err != nil {
+			return mapNumErr(val, err)
+		}
+
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(val, 10, field.Type().Bits())
+
+		if err != nil {
+			return mapNumErr(val, err)
+		}
+
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(val, 10, field.Type().Bits())
+
+		if err != nil {
+			return mapNumErr(val, err)
+		}
+
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(val, field.Type().Bits())
+
+		if err != nil {
+			return mapNumErr(val, err)
+		}
+
+		field.SetFloat(f)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return errors.New("unsupported field type: " + field.Type().String())
+		}
+
+		parts := strings.Split(val, ",")
+
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+		}
+
+		field.Set(reflect.ValueOf(parts))
+	default:
+		return errors.New("unsupported field type: " + field.Type().String())
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package vald
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBind(t *testing.T) {
+	type Form struct {
+		Name     string   `vald:"key=name,required"`
+		IsOK     bool     `vald:"key=isOK,check=bool"`
+		Tags     []string `vald:"key=tags"`
+		Untagged string
+	}
+
+	src := map[string]string{
+		"name": "Alice",
+		"isOK": "1",
+		"tags": "a, b, c",
+	}
+
+	var form Form
+
+	if err := Bind(FromMap(src), &form); err != nil {
+		t.Fatal(err)
+	}
+
+	if form.Name != "Alice" {
+		t.Errorf("unexpected Name: %q", form.Name)
+	}
+
+	if !form.IsOK {
+		t.Error("unexpected IsOK: false")
+	}
+
+	if len(form.Tags) != 3 || form.Tags[0] != "a" || form.Tags[1] != "b" || form.Tags[2] != "c" {
+		t.Errorf("unexpected Tags: %v", form.Tags)
+	}
+
+	if form.Untagged != "" {
+		t.Errorf("untagged field was modified: %q", form.Untagged)
+	}
+}
+
+func TestBindRangeChecker(t *testing.T) {
+	type Form struct {
+		Age string `vald:"key=age,check=int:1..120"`
+	}
+
+	var form Form
+
+	if err := Bind(FromMap(map[string]string{"age": "42"}), &form); err != nil {
+		t.Fatal(err)
+	}
+
+	if form.Age != "42" {
+		t.Errorf("unexpected Age: %q", form.Age)
+	}
+
+	err := Bind(FromMap(map[string]string{"age": "121"}), &form)
+
+	if err == nil {
+		t.Fatal("missing error for out-of-range age")
+	}
+
+	var errs Errors
+
+	if !errors.As(err, &errs) {
+		t.Fatalf("error is not of type Errors: %v", err)
+	}
+
+	if _, ok := errs["age"]; !ok {
+		t.Error(`missing error for key "age"`)
+	}
+}
+
+func TestBindErrors(t *testing.T) {
+	type Form struct {
+		Name string `vald:"key=name,required"`
+		Age  string `vald:"key=age,check=unknown"`
+		IsOK bool   `vald:"key=isOK,check=bool"`
+	}
+
+	src := map[string]string{
+		"age":  "42",
+		"isOK": "nope",
+	}
+
+	var form Form
+
+	err := Bind(FromMap(src), &form)
+
+	if err == nil {
+		t.Fatal("missing error")
+	}
+
+	var errs Errors
+
+	if !errors.As(err, &errs) {
+		t.Fatalf("error is not of type Errors: %v", err)
+	}
+
+	for _, key := range []string{"name", "age", "isOK"} {
+		if _, ok := errs[key]; !ok {
+			t.Errorf("missing error for key %q", key)
+		}
+	}
+}
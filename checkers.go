@@ -0,0 +1,187 @@
+/*
+Copyright (c) 2022 Maxim Konakov
+All rights reserved.
+
+Redistribution and use in source and binary forms, with or without modification,
+are permitted provided that the following conditions are met:
+
+1. Redistributions of source code must retain the above copyright notice,
+   this list of conditions and the following disclaimer.
+2. Redistributions in binary form must reproduce the above copyright notice,
+   this list of conditions and the following disclaimer in the documentation
+   and/or other materials provided with the distribution.
+3. Neither the name of the copyright holder nor the names of its contributors
+   may be used to endorse or promote products derived from this software without
+   specific prior written permission.
+
+THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED.
+IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT,
+INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING
+NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE,
+EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+*/
+
+package vald
+
+import (
+	"errors"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Int constructs a Checker function that validates its argument as a decimal
+// integer in the range [min, max], returning the value in canonical form.
+func Int(min, max int64) Checker {
+	if min > max {
+		panic("min > max in vald.Int()")
+	}
+
+	return func(val string) (string, error) {
+		n, err := strconv.ParseInt(val, 10, 64)
+
+		if err != nil {
+			return "", mapNumErr(val, err)
+		}
+
+		if n < min || n > max {
+			return "", errors.New(strconv.Quote(val) + ": out of range")
+		}
+
+		return strconv.FormatInt(n, 10), nil
+	}
+}
+
+// Uint constructs a Checker function that validates its argument as an unsigned
+// decimal integer in the range [min, max], returning the value in canonical form.
+func Uint(min, max uint64) Checker {
+	if min > max {
+		panic("min > max in vald.Uint()")
+	}
+
+	return func(val string) (string, error) {
+		n, err := strconv.ParseUint(val, 10, 64)
+
+		if err != nil {
+			return "", mapNumErr(val, err)
+		}
+
+		if n < min || n > max {
+			return "", errors.New(strconv.Quote(val) + ": out of range")
+		}
+
+		return strconv.FormatUint(n, 10), nil
+	}
+}
+
+// Float constructs a Checker function that validates its argument as a
+// floating-point number in the range [min, max], returning the value in
+// canonical form.
+func Float(min, max float64) Checker {
+	if min > max {
+		panic("min > max in vald.Float()")
+	}
+
+	return func(val string) (string, error) {
+		f, err := strconv.ParseFloat(val, 64)
+
+		if err != nil {
+			return "", mapNumErr(val, err)
+		}
+
+		if f < min || f > max {
+			return "", errors.New(strconv.Quote(val) + ": out of range")
+		}
+
+		return strconv.FormatFloat(f, 'g', -1, 64), nil
+	}
+}
+
+// Length constructs a Checker function that validates that its argument has a
+// rune count in the range [min, max].
+func Length(min, max int) Checker {
+	if min < 0 || min > max {
+		panic("invalid range in vald.Length()")
+	}
+
+	return func(val string) (string, error) {
+		if n := utf8.RuneCountInString(val); n < min || n > max {
+			return "", errors.New(strconv.Quote(val) + ": length out of range")
+		}
+
+		return val, nil
+	}
+}
+
+// ByteLength constructs a Checker function that validates that its argument has
+// a byte length in the range [min, max].
+func ByteLength(min, max int) Checker {
+	if min < 0 || min > max {
+		panic("invalid range in vald.ByteLength()")
+	}
+
+	return func(val string) (string, error) {
+		if n := len(val); n < min || n > max {
+			return "", errors.New(strconv.Quote(val) + ": length out of range")
+		}
+
+		return val, nil
+	}
+}
+
+// And constructs a Checker function that runs the given checkers in order,
+// feeding each one's canonicalized output into the next, and fails as soon as
+// any of them fails.
+func And(checks ...Checker) Checker {
+	if len(checks) == 0 {
+		panic("empty checker list in vald.And()")
+	}
+
+	return func(val string) (s string, err error) {
+		s = val
+
+		for _, check := range checks {
+			if s, err = check(s); err != nil {
+				return "", err
+			}
+		}
+
+		return
+	}
+}
+
+// Or constructs a Checker function that tries the given checkers in order
+// against the original value and succeeds with the first one that does, or
+// fails with the last checker's error if none of them do.
+func Or(checks ...Checker) Checker {
+	if len(checks) == 0 {
+		panic("empty checker list in vald.Or()")
+	}
+
+	return func(val string) (s string, err error) {
+		for _, check := range checks {
+			if s, err = check(val); err == nil {
+				return s, nil
+			}
+		}
+
+		return "", err
+	}
+}
+
+// Not constructs a Checker function that succeeds, returning the value
+// unchanged, when the given Checker fails, and fails with the given message
+// when it succeeds.
+func Not(c Checker, msg string) Checker {
+	return func(val string) (string, error) {
+		if _, err := c(val); err == nil {
+			return "", errors.New(msg)
+		}
+
+		return val, nil
+	}
+}
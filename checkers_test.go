@@ -0,0 +1,83 @@
+package vald
+
+import "testing"
+
+func TestRangeCheckers(t *testing.T) {
+	cases := []struct {
+		name    string
+		check   Checker
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"Int/ok", Int(1, 120), "42", "42", false},
+		{"Int/out-of-range", Int(1, 120), "121", "", true},
+		{"Int/not-a-number", Int(1, 120), "abc", "", true},
+		{"Uint/ok", Uint(0, 10), "7", "7", false},
+		{"Uint/out-of-range", Uint(0, 10), "11", "", true},
+		{"Float/ok", Float(0, 1), "0.5", "0.5", false},
+		{"Float/out-of-range", Float(0, 1), "1.5", "", true},
+		{"Length/ok", Length(1, 3), "ab", "ab", false},
+		{"Length/too-long", Length(1, 3), "abcd", "", true},
+		{"ByteLength/ok", ByteLength(1, 3), "ab", "ab", false},
+		{"ByteLength/too-long", ByteLength(1, 3), "abcd", "", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.check(tc.value)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("missing error for %q", tc.value)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error for %q: %v", tc.value, err)
+			}
+
+			if got != tc.want {
+				t.Errorf("got %q instead of %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCombinators(t *testing.T) {
+	and := And(Length(3, 64), Regex(`^[a-z]+@[a-z]+$`))
+
+	if _, err := and("a@"); err == nil {
+		t.Error("And: missing error for too-short value")
+	}
+
+	if _, err := and("abc@x"); err != nil {
+		t.Errorf("And: unexpected error: %v", err)
+	}
+
+	or := Or(Int(0, 10), Int(100, 110))
+
+	if _, err := or("5"); err != nil {
+		t.Errorf("Or: unexpected error: %v", err)
+	}
+
+	if _, err := or("105"); err != nil {
+		t.Errorf("Or: unexpected error: %v", err)
+	}
+
+	if _, err := or("50"); err == nil {
+		t.Error("Or: missing error for out-of-range value")
+	}
+
+	not := Not(OneOf("xxx"), "must not be xxx")
+
+	if _, err := not("yyy"); err != nil {
+		t.Errorf("Not: unexpected error: %v", err)
+	}
+
+	if _, err := not("xxx"); err == nil {
+		t.Error("Not: missing error for disallowed value")
+	}
+}
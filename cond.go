@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2022 Maxim Konakov
+All rights reserved.
+
+Redistribution and use in source and binary forms, with or without modification,
+are permitted provided that the following conditions are met:
+
+1. Redistributions of source code must retain the above copyright notice,
+   this list of conditions and the following disclaimer.
+2. Redistributions in binary form must reproduce the above copyright notice,
+   this list of conditions and the following disclaimer in the documentation
+   and/or other materials provided with the distribution.
+3. Neither the name of the copyright holder nor the names of its contributors
+   may be used to endorse or promote products derived from this software without
+   specific prior written permission.
+
+THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED.
+IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT,
+INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING
+NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE,
+EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+*/
+
+package vald
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// When constructs a Validator that runs yes if pred(get) is true, or no
+// otherwise. Either branch may be nil, in which case nothing happens for it.
+func When(pred func(Getter) bool, yes, no Validator) Validator {
+	return func(get Getter, cons Consumer) error {
+		if pred(get) {
+			if yes != nil {
+				return yes(get, cons)
+			}
+		} else if no != nil {
+			return no(get, cons)
+		}
+
+		return nil
+	}
+}
+
+// RequiredIf constructs a Validator that requires the given key to be present
+// and validates it with check, but only when when(get) is true; otherwise the
+// validator does nothing.
+func RequiredIf(key string, when func(Getter) bool, check Checker) Validator {
+	return When(when, Req(key, check), nil)
+}
+
+// RequiredUnless is like RequiredIf, but the key is required when when(get) is
+// false instead of true.
+func RequiredUnless(key string, when func(Getter) bool, check Checker) Validator {
+	return When(when, nil, Req(key, check))
+}
+
+// EqualsField constructs a Validator that requires the values of key and
+// otherKey to be equal once both are trimmed of leading and trailing
+// whitespace. On success it invokes the Consumer with key and the trimmed
+// value, e.g. for confirming a password field against the original.
+func EqualsField(key, otherKey string) Validator {
+	return func(get Getter, cons Consumer) error {
+		val := strings.TrimSpace(get(key))
+
+		if val != strings.TrimSpace(get(otherKey)) {
+			return &fieldErr{key, errors.New("must equal " + strconv.Quote(otherKey))}
+		}
+
+		if err := cons(key, val); err != nil {
+			return &fieldErr{key, err}
+		}
+
+		return nil
+	}
+}
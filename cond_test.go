@@ -0,0 +1,100 @@
+package vald
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRequiredIf(t *testing.T) {
+	hasType := func(get Getter) bool { return get("type") == "business" }
+
+	validate := Pack(
+		Opt("type", OneOf("personal", "business")),
+		RequiredIf("vat", hasType, Regex(`^[A-Z]{2}[0-9]+$`)),
+	)
+
+	if _, err := validate.Map(FromMap(map[string]string{"type": "business", "vat": "GB123"})); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, err := validate.Map(FromMap(map[string]string{"type": "business"})); err == nil {
+		t.Error("missing error for absent vat when type is business")
+	}
+
+	if _, err := validate.Map(FromMap(map[string]string{"type": "personal"})); err != nil {
+		t.Errorf("unexpected error when vat is not required: %v", err)
+	}
+}
+
+func TestRequiredUnless(t *testing.T) {
+	isGuest := func(get Getter) bool { return get("role") == "guest" }
+
+	validate := RequiredUnless("email", isGuest, Regex(`^\S+@\S+$`))
+
+	if _, err := validate.Map(FromMap(map[string]string{"role": "guest"})); err != nil {
+		t.Errorf("unexpected error for guest without email: %v", err)
+	}
+
+	if _, err := validate.Map(FromMap(map[string]string{"role": "member"})); err == nil {
+		t.Error("missing error for member without email")
+	}
+}
+
+func TestEqualsField(t *testing.T) {
+	validate := EqualsField("confirm_password", "password")
+
+	m, err := validate.Map(FromMap(map[string]string{
+		"password":         "secret",
+		"confirm_password": " secret ",
+	}))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m["confirm_password"] != "secret" {
+		t.Errorf("unexpected value: %q", m["confirm_password"])
+	}
+
+	_, err = validate.Map(FromMap(map[string]string{
+		"password":         "secret",
+		"confirm_password": "other",
+	}))
+
+	if err == nil {
+		t.Fatal("missing error for mismatching fields")
+	}
+
+	const expected = `must equal "password"`
+
+	if err.Error() != expected {
+		t.Errorf("unexpected error message: %q instead of %q", err, expected)
+	}
+
+	var errs Errors
+
+	if errors.As(err, &errs) {
+		t.Error("EqualsField error should not already be an Errors value")
+	}
+
+	// the field's key must appear exactly once once the error is aggregated
+	// through PackAll, not be duplicated by EqualsField itself.
+	_, err = PackAll(validate).Map(FromMap(map[string]string{
+		"password":         "secret",
+		"confirm_password": "other",
+	}))
+
+	if err == nil {
+		t.Fatal("missing error from PackAll for mismatching fields")
+	}
+
+	if !errors.As(err, &errs) {
+		t.Fatalf("error is not of type Errors: %v", err)
+	}
+
+	const expectedAggregate = `confirm_password: must equal "password"`
+
+	if err.Error() != expectedAggregate {
+		t.Errorf("unexpected aggregate error message: %q instead of %q", err, expectedAggregate)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2022 Maxim Konakov
+All rights reserved.
+
+Redistribution and use in source and binary forms, with or without modification,
+are permitted provided that the following conditions are met:
+
+1. Redistributions of source code must retain the above copyright notice,
+   this list of conditions and the following disclaimer.
+2. Redistributions in binary form must reproduce the above copyright notice,
+   this list of conditions and the following disclaimer in the documentation
+   and/or other materials provided with the distribution.
+3. Neither the name of the copyright holder nor the names of its contributors
+   may be used to endorse or promote products derived from this software without
+   specific prior written permission.
+
+THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED.
+IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT,
+INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING
+NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE,
+EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+*/
+
+package vald
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+)
+
+// Errors is a collection of per-field validation errors, keyed by field name.
+// It is returned by PackAll() and is inspectable via errors.As().
+type Errors map[string]error
+
+// Error implements the error interface, rendering the collected errors as
+// "field1: msg1; field2: msg2", sorted by key for deterministic output.
+func (errs Errors) Error() string {
+	keys := make([]string, 0, len(errs))
+
+	for k := range errs {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	var b strings.Builder
+
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+
+		b.WriteString(k)
+		b.WriteString(": ")
+		b.WriteString(errs[k].Error())
+	}
+
+	return b.String()
+}
+
+// MarshalJSON implements json.Marshaler, rendering the collected errors as a
+// JSON object of field name to error message.
+func (errs Errors) MarshalJSON() ([]byte, error) {
+	m := make(map[string]string, len(errs))
+
+	for k, err := range errs {
+		m[k] = err.Error()
+	}
+
+	return json.Marshal(m)
+}
+
+// PackAll constructs a new validator that when called invokes every given validator
+// in turn, regardless of failures, and collects all the errors into an Errors value
+// keyed by field name. Consumer errors contribute to the collection the same way as
+// Checker errors do, instead of stopping the validation early. The function returns
+// nil if every validator succeeds.
+func PackAll(validators ...Validator) Validator {
+	if len(validators) == 0 {
+		panic("empty validator list in vald.PackAll()")
+	}
+
+	return func(get Getter, cons Consumer) error {
+		errs := make(Errors)
+
+		for _, validate := range validators {
+			err := validate(get, cons)
+
+			if err == nil {
+				continue
+			}
+
+			var nested Errors
+
+			if errors.As(err, &nested) {
+				for k, e := range nested {
+					errs[k] = e
+				}
+
+				continue
+			}
+
+			var fe *fieldErr
+
+			if errors.As(err, &fe) {
+				errs[fe.key] = fe.err
+			} else {
+				errs[""] = err
+			}
+		}
+
+		if len(errs) == 0 {
+			return nil
+		}
+
+		return errs
+	}
+}
@@ -0,0 +1,91 @@
+package vald
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestErrorsRendering(t *testing.T) {
+	src := map[string]string{
+		"bbb": "yyy",
+	}
+
+	validate := PackAll(
+		Req("aaa", OneOf("xxx", "yyy", "zzz")),
+		Req("bbb", Regex(`^[a-z]{3}$`)),
+		Req("ccc", Bool),
+	)
+
+	_, err := validate.Map(FromMap(src))
+
+	if err == nil {
+		t.Fatal("missing error")
+	}
+
+	var errs Errors
+
+	if !errors.As(err, &errs) {
+		t.Fatalf("error is not of type Errors: %v", err)
+	}
+
+	const expected = `aaa: parameter "aaa": missing value; ccc: parameter "ccc": missing value`
+
+	if got := errs.Error(); got != expected {
+		t.Errorf("unexpected Error() string: %q instead of %q", got, expected)
+	}
+
+	data, err := json.Marshal(errs)
+
+	if err != nil {
+		t.Fatalf("MarshalJSON failed: %v", err)
+	}
+
+	var decoded map[string]string
+
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("round-trip unmarshal failed: %v", err)
+	}
+
+	if len(decoded) != 2 {
+		t.Fatalf("unexpected number of decoded keys: %d instead of 2", len(decoded))
+	}
+
+	for _, key := range []string{"aaa", "ccc"} {
+		if decoded[key] != errs[key].Error() {
+			t.Errorf("unexpected decoded message for key %q: %q instead of %q", key, decoded[key], errs[key].Error())
+		}
+	}
+}
+
+func TestPackAllConsumerError(t *testing.T) {
+	failingCons := func(k, v string) error {
+		if k == "AAA" {
+			return errors.New("rejected")
+		}
+
+		return nil
+	}
+
+	validate := PackAll(OptDef("AAA", Regex(`^[A-Z]{3}$`), "XXX"))
+
+	err := validate(FromMap(map[string]string{}), failingCons)
+
+	if err == nil {
+		t.Fatal("missing error")
+	}
+
+	var errs Errors
+
+	if !errors.As(err, &errs) {
+		t.Fatalf("error is not of type Errors: %v", err)
+	}
+
+	if _, ok := errs["AAA"]; !ok {
+		t.Errorf(`missing error for key "AAA", got: %v`, errs)
+	}
+
+	if _, ok := errs[""]; ok {
+		t.Error(`consumer error from OptDef must not be collapsed under the empty key`)
+	}
+}
@@ -0,0 +1,348 @@
+/*
+Copyright (c) 2022 Maxim Konakov
+All rights reserved.
+
+Redistribution and use in source and binary forms, with or without modification,
+are permitted provided that the following conditions are met:
+
+1. Redistributions of source code must retain the above copyright notice,
+   this list of conditions and the following disclaimer.
+2. Redistributions in binary form must reproduce the above copyright notice,
+   this list of conditions and the following disclaimer in the documentation
+   and/or other materials provided with the distribution.
+3. Neither the name of the copyright holder nor the names of its contributors
+   may be used to endorse or promote products derived from this software without
+   specific prior written permission.
+
+THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED.
+IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT,
+INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING
+NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE,
+EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+*/
+
+/*
+Package is provides a library of ready-made vald.Checker values for common data
+formats, such as email addresses, URLs, UUIDs, and IP addresses. Every checker
+returns a canonicalised form of its input when that is meaningful, so that the
+value reaching a vald.Consumer is already in normal form.
+*/
+package is
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Checker is an alias of vald.Checker, avoided here to keep this package free of
+// a dependency on the parent package; the function signature is identical, so
+// every value below satisfies vald.Checker without conversion.
+type Checker = func(string) (string, error)
+
+func quote(val string) error {
+	return errors.New(strconv.Quote(val))
+}
+
+func fromRegex(patt string) Checker {
+	match := regexp.MustCompile(patt).MatchString
+
+	return func(val string) (string, error) {
+		if !match(val) {
+			return "", quote(val)
+		}
+
+		return val, nil
+	}
+}
+
+// Alpha checks that the value consists only of Latin letters.
+var Alpha = fromRegex(`^[A-Za-z]+$`)
+
+// Alphanumeric checks that the value consists only of Latin letters and digits.
+var Alphanumeric = fromRegex(`^[A-Za-z0-9]+$`)
+
+// Digit checks that the value consists only of decimal digits.
+var Digit = fromRegex(`^[0-9]+$`)
+
+// Email checks that the value is a valid e-mail address, returning it with the
+// domain part lower-cased.
+func Email(val string) (string, error) {
+	addr, err := mail.ParseAddress(val)
+
+	if err != nil || addr.Address != val {
+		return "", quote(val)
+	}
+
+	at := strings.LastIndexByte(val, '@')
+
+	return val[:at] + strings.ToLower(val[at:]), nil
+}
+
+// URL checks that the value is a valid, absolute URL.
+func URL(val string) (string, error) {
+	u, err := url.Parse(val)
+
+	if err != nil || !u.IsAbs() || u.Host == "" {
+		return "", quote(val)
+	}
+
+	return val, nil
+}
+
+// RequestURL checks that the value is a valid URL usable as an HTTP(S) request
+// target, i.e. an absolute URL with an "http" or "https" scheme.
+func RequestURL(val string) (string, error) {
+	u, err := url.Parse(val)
+
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", quote(val)
+	}
+
+	return val, nil
+}
+
+// Int checks that the value is a valid decimal integer, returning it in
+// canonical form.
+func Int(val string) (string, error) {
+	n, err := strconv.ParseInt(val, 10, 64)
+
+	if err != nil {
+		return "", quote(val)
+	}
+
+	return strconv.FormatInt(n, 10), nil
+}
+
+// Float checks that the value is a valid floating-point number, returning it in
+// canonical form.
+func Float(val string) (string, error) {
+	f, err := strconv.ParseFloat(val, 64)
+
+	if err != nil {
+		return "", quote(val)
+	}
+
+	return strconv.FormatFloat(f, 'g', -1, 64), nil
+}
+
+// uuid pattern for the given version nibble, e.g. "4" for UUID v4.
+func uuidChecker(version string) Checker {
+	return fromRegex(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-` + version +
+		`[0-9a-fA-F]{3}-[89abAB][0-9a-fA-F]{3}-[0-9a-fA-F]{12}$`)
+}
+
+var uuidAny = fromRegex(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[1-5][0-9a-fA-F]{3}-[89abAB][0-9a-fA-F]{3}-[0-9a-fA-F]{12}$`)
+
+// UUID checks that the value is a valid UUID of any version from 1 to 5,
+// returning it lower-cased.
+func UUID(val string) (string, error) {
+	if _, err := uuidAny(val); err != nil {
+		return "", err
+	}
+
+	return strings.ToLower(val), nil
+}
+
+// UUIDv1, UUIDv3, UUIDv4 and UUIDv5 check that the value is a valid UUID of the
+// corresponding version, returning it lower-cased.
+var (
+	UUIDv1 = lowerUUID(uuidChecker("1"))
+	UUIDv3 = lowerUUID(uuidChecker("3"))
+	UUIDv4 = lowerUUID(uuidChecker("4"))
+	UUIDv5 = lowerUUID(uuidChecker("5"))
+)
+
+func lowerUUID(check Checker) Checker {
+	return func(val string) (string, error) {
+		if _, err := check(val); err != nil {
+			return "", err
+		}
+
+		return strings.ToLower(val), nil
+	}
+}
+
+// IPv4 checks that the value is a valid IPv4 address, returning it in canonical
+// form.
+func IPv4(val string) (string, error) {
+	ip := net.ParseIP(val)
+
+	if ip == nil || ip.To4() == nil {
+		return "", quote(val)
+	}
+
+	return ip.To4().String(), nil
+}
+
+// IPv6 checks that the value is a valid IPv6 address, returning it in canonical
+// form.
+func IPv6(val string) (string, error) {
+	ip := net.ParseIP(val)
+
+	if ip == nil || ip.To4() != nil {
+		return "", quote(val)
+	}
+
+	return ip.String(), nil
+}
+
+// CIDR checks that the value is a valid CIDR notation IP address and prefix
+// length, returning it in canonical form.
+func CIDR(val string) (string, error) {
+	_, ipNet, err := net.ParseCIDR(val)
+
+	if err != nil {
+		return "", quote(val)
+	}
+
+	return ipNet.String(), nil
+}
+
+// MAC checks that the value is a valid IEEE 802 MAC address, returning it in
+// canonical form.
+func MAC(val string) (string, error) {
+	addr, err := net.ParseMAC(val)
+
+	if err != nil {
+		return "", quote(val)
+	}
+
+	return addr.String(), nil
+}
+
+// Hex checks that the value is a valid hexadecimal string, returning it
+// lower-cased.
+func Hex(val string) (string, error) {
+	if _, err := fromRegex(`^[0-9a-fA-F]+$`)(val); err != nil {
+		return "", err
+	}
+
+	return strings.ToLower(val), nil
+}
+
+// Base64 checks that the value is valid standard base64-encoded data.
+func Base64(val string) (string, error) {
+	if _, err := base64.StdEncoding.DecodeString(val); err != nil {
+		return "", quote(val)
+	}
+
+	return val, nil
+}
+
+// JSON checks that the value is syntactically valid JSON.
+func JSON(val string) (string, error) {
+	if !json.Valid([]byte(val)) {
+		return "", quote(val)
+	}
+
+	return val, nil
+}
+
+// E164 checks that the value is a valid E.164 phone number, e.g. "+12025550123".
+var E164 = fromRegex(`^\+[1-9]\d{1,14}$`)
+
+// CreditCard checks that the value is a string of 12 to 19 digits passing the
+// Luhn checksum.
+func CreditCard(val string) (string, error) {
+	if _, err := fromRegex(`^[0-9]{12,19}$`)(val); err != nil {
+		return "", err
+	}
+
+	sum, alt := 0, false
+
+	for i := len(val) - 1; i >= 0; i-- {
+		d := int(val[i] - '0')
+
+		if alt {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+
+		sum += d
+		alt = !alt
+	}
+
+	if sum%10 != 0 {
+		return "", quote(val)
+	}
+
+	return val, nil
+}
+
+// Latitude checks that the value is a valid latitude in the range [-90, 90].
+func Latitude(val string) (string, error) {
+	f, err := strconv.ParseFloat(val, 64)
+
+	if err != nil || f < -90 || f > 90 {
+		return "", quote(val)
+	}
+
+	return strconv.FormatFloat(f, 'g', -1, 64), nil
+}
+
+// Longitude checks that the value is a valid longitude in the range [-180, 180].
+func Longitude(val string) (string, error) {
+	f, err := strconv.ParseFloat(val, 64)
+
+	if err != nil || f < -180 || f > 180 {
+		return "", quote(val)
+	}
+
+	return strconv.FormatFloat(f, 'g', -1, 64), nil
+}
+
+// Semver checks that the value is a valid semantic version as defined by
+// semver.org, e.g. "1.2.3-alpha.1+build.5".
+var Semver = fromRegex(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(-(0|[1-9]\d*|\d*[A-Za-z-][0-9A-Za-z-]*)(\.(0|[1-9]\d*|\d*[A-Za-z-][0-9A-Za-z-]*))*)?` +
+	`(\+[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?$`)
+
+// RFC3339 checks that the value is a valid RFC 3339 date-time, returning it
+// re-formatted in canonical RFC 3339 form.
+func RFC3339(val string) (string, error) {
+	t, err := time.Parse(time.RFC3339, val)
+
+	if err != nil {
+		return "", quote(val)
+	}
+
+	return t.Format(time.RFC3339), nil
+}
+
+// ISO3166Alpha2 checks that the value is a valid ISO 3166-1 alpha-2 country
+// code, returning it upper-cased.
+func ISO3166Alpha2(val string) (string, error) {
+	code := strings.ToUpper(val)
+
+	if !iso3166Alpha2[code] {
+		return "", quote(val)
+	}
+
+	return code, nil
+}
+
+// ISO4217 checks that the value is a valid ISO 4217 currency code, returning it
+// upper-cased.
+func ISO4217(val string) (string, error) {
+	code := strings.ToUpper(val)
+
+	if !iso4217[code] {
+		return "", quote(val)
+	}
+
+	return code, nil
+}
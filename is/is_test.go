@@ -0,0 +1,86 @@
+package is
+
+import "testing"
+
+func TestCheckers(t *testing.T) {
+	cases := []struct {
+		name    string
+		check   Checker
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"Alpha/ok", Alpha, "abcXYZ", "abcXYZ", false},
+		{"Alpha/bad", Alpha, "abc123", "", true},
+		{"Alphanumeric/ok", Alphanumeric, "abc123", "abc123", false},
+		{"Alphanumeric/bad", Alphanumeric, "abc-123", "", true},
+		{"Digit/ok", Digit, "01234", "01234", false},
+		{"Digit/bad", Digit, "12a", "", true},
+		{"Email/ok", Email, "User@EXAMPLE.com", "User@example.com", false},
+		{"Email/bad", Email, "not-an-email", "", true},
+		{"URL/ok", URL, "https://example.com/path", "https://example.com/path", false},
+		{"URL/bad", URL, "not a url", "", true},
+		{"RequestURL/ok", RequestURL, "http://example.com", "http://example.com", false},
+		{"RequestURL/bad", RequestURL, "ftp://example.com", "", true},
+		{"Int/ok", Int, "042", "42", false},
+		{"Int/bad", Int, "4.2", "", true},
+		{"Float/ok", Float, "3.140", "3.14", false},
+		{"Float/bad", Float, "abc", "", true},
+		{"UUID/ok", UUID, "123E4567-E89B-42D3-A456-426614174000", "123e4567-e89b-42d3-a456-426614174000", false},
+		{"UUID/bad", UUID, "not-a-uuid", "", true},
+		{"UUIDv4/ok", UUIDv4, "123e4567-e89b-42d3-a456-426614174000", "123e4567-e89b-42d3-a456-426614174000", false},
+		{"UUIDv4/bad-version", UUIDv4, "123e4567-e89b-12d3-a456-426614174000", "", true},
+		{"IPv4/ok", IPv4, "192.168.0.1", "192.168.0.1", false},
+		{"IPv4/bad", IPv4, "::1", "", true},
+		{"IPv6/ok", IPv6, "::1", "::1", false},
+		{"IPv6/bad", IPv6, "192.168.0.1", "", true},
+		{"CIDR/ok", CIDR, "192.168.0.0/24", "192.168.0.0/24", false},
+		{"CIDR/bad", CIDR, "192.168.0.0", "", true},
+		{"MAC/ok", MAC, "01:23:45:67:89:ab", "01:23:45:67:89:ab", false},
+		{"MAC/bad", MAC, "not-a-mac", "", true},
+		{"Hex/ok", Hex, "1A2B3C", "1a2b3c", false},
+		{"Hex/bad", Hex, "1A2B3G", "", true},
+		{"Base64/ok", Base64, "aGVsbG8=", "aGVsbG8=", false},
+		{"Base64/bad", Base64, "not base64!", "", true},
+		{"JSON/ok", JSON, `{"a":1}`, `{"a":1}`, false},
+		{"JSON/bad", JSON, `{a:1}`, "", true},
+		{"E164/ok", E164, "+12025550123", "+12025550123", false},
+		{"E164/bad", E164, "02025550123", "", true},
+		{"CreditCard/ok", CreditCard, "4111111111111111", "4111111111111111", false},
+		{"CreditCard/bad", CreditCard, "4111111111111112", "", true},
+		{"Latitude/ok", Latitude, "45.5", "45.5", false},
+		{"Latitude/bad", Latitude, "91", "", true},
+		{"Longitude/ok", Longitude, "-120.5", "-120.5", false},
+		{"Longitude/bad", Longitude, "181", "", true},
+		{"Semver/ok", Semver, "1.2.3-alpha.1+build.5", "1.2.3-alpha.1+build.5", false},
+		{"Semver/bad", Semver, "1.2", "", true},
+		{"RFC3339/ok", RFC3339, "2022-01-02T15:04:05Z", "2022-01-02T15:04:05Z", false},
+		{"RFC3339/bad", RFC3339, "2022-01-02", "", true},
+		{"ISO3166Alpha2/ok", ISO3166Alpha2, "gb", "GB", false},
+		{"ISO3166Alpha2/bad", ISO3166Alpha2, "zz", "", true},
+		{"ISO4217/ok", ISO4217, "usd", "USD", false},
+		{"ISO4217/bad", ISO4217, "xyz", "", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.check(tc.value)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("missing error for %q", tc.value)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error for %q: %v", tc.value, err)
+			}
+
+			if got != tc.want {
+				t.Errorf("got %q instead of %q", got, tc.want)
+			}
+		})
+	}
+}
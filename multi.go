@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2022 Maxim Konakov
+All rights reserved.
+
+Redistribution and use in source and binary forms, with or without modification,
+are permitted provided that the following conditions are met:
+
+1. Redistributions of source code must retain the above copyright notice,
+   this list of conditions and the following disclaimer.
+2. Redistributions in binary form must reproduce the above copyright notice,
+   this list of conditions and the following disclaimer in the documentation
+   and/or other materials provided with the distribution.
+3. Neither the name of the copyright holder nor the names of its contributors
+   may be used to endorse or promote products derived from this software without
+   specific prior written permission.
+
+THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
+ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE IMPLIED
+WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED.
+IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT,
+INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY
+OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING
+NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE,
+EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+*/
+
+package vald
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+)
+
+// MultiGetter is the type of function that given a key returns all of its
+// values, or nil if the key is not found.
+// Typically, the getter is FromValues applied to a url.Values.
+type MultiGetter = func(string) []string
+
+// MultiConsumer is the type of callback function to be called with a key and
+// all of its validated values.
+type MultiConsumer = func(string, []string) error
+
+// MultiValidator is the type of function that performs validation against a
+// MultiGetter.
+type MultiValidator func(MultiGetter, MultiConsumer) error
+
+// FromValues constructs a MultiGetter from the given url.Values.
+func FromValues(v url.Values) MultiGetter {
+	return func(k string) []string {
+		return v[k]
+	}
+}
+
+// Single adapts a MultiGetter to a Getter by taking the first of its values (or
+// the empty string if there are none), so that existing single-value Validator
+// functions keep working against multi-valued sources.
+func Single(get MultiGetter) Getter {
+	return func(k string) string {
+		if vals := get(k); len(vals) > 0 {
+			return vals[0]
+		}
+
+		return ""
+	}
+}
+
+// ReqEach constructs a MultiValidator that retrieves every value for the given
+// key, validates each one with the given Checker, and passes the whole
+// validated slice to the MultiConsumer in one call. The validator returns an
+// error if the key has no values.
+func ReqEach(key string, check Checker) MultiValidator {
+	return func(get MultiGetter, cons MultiConsumer) error {
+		vals := get(key)
+
+		if len(vals) == 0 {
+			return &fieldErr{key, errors.New("parameter " + strconv.Quote(key) + ": missing value")}
+		}
+
+		return checkEach(key, vals, check, cons)
+	}
+}
+
+// OptEach is like ReqEach, but does nothing if the key has no values.
+func OptEach(key string, check Checker) MultiValidator {
+	return func(get MultiGetter, cons MultiConsumer) error {
+		if vals := get(key); len(vals) > 0 {
+			return checkEach(key, vals, check, cons)
+		}
+
+		return nil
+	}
+}
+
+// ReqN is like ReqEach, but instead of requiring at least one value it requires
+// the number of values found for the key to be in the range [min, max]. With a
+// min of 0 a missing key passes, and the MultiConsumer is called with an empty
+// slice.
+func ReqN(key string, min, max int, check Checker) MultiValidator {
+	if min < 0 || min > max {
+		panic("invalid range in vald.ReqN()")
+	}
+
+	return func(get MultiGetter, cons MultiConsumer) error {
+		vals := get(key)
+
+		if n := len(vals); n < min || n > max {
+			return &fieldErr{key, errors.New("parameter " + strconv.Quote(key) + ": expected between " +
+				strconv.Itoa(min) + " and " + strconv.Itoa(max) + " values, got " + strconv.Itoa(n))}
+		}
+
+		return checkEach(key, vals, check, cons)
+	}
+}
+
+// checkEach validates every value in vals with check and, if all of them pass,
+// invokes cons once with the whole canonicalized slice.
+func checkEach(key string, vals []string, check Checker, cons MultiConsumer) error {
+	out := make([]string, len(vals))
+
+	for i, val := range vals {
+		checked, err := check(val)
+
+		if err != nil {
+			return &fieldErr{key, errors.New("parameter " + strconv.Quote(key) + ": " + err.Error())}
+		}
+
+		out[i] = checked
+	}
+
+	if err := cons(key, out); err != nil {
+		return &fieldErr{key, err}
+	}
+
+	return nil
+}
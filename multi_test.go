@@ -0,0 +1,95 @@
+package vald
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestReqEach(t *testing.T) {
+	get := FromValues(url.Values{
+		"tags": {"go", "VALD"},
+	})
+
+	var got []string
+
+	err := ReqEach("tags", Regex(`^[A-Za-z]+$`))(get, func(k string, v []string) error {
+		if k != "tags" {
+			t.Errorf("unexpected key: %q", k)
+		}
+
+		got = v
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, []string{"go", "VALD"}) {
+		t.Errorf("unexpected values: %v", got)
+	}
+}
+
+func TestReqEachMissing(t *testing.T) {
+	get := FromValues(url.Values{})
+
+	err := ReqEach("tags", Regex(`^[A-Za-z]+$`))(get, func(string, []string) error {
+		t.Fatal("consumer should not be called")
+		return nil
+	})
+
+	if err == nil {
+		t.Fatal("missing error")
+	}
+}
+
+func TestOptEach(t *testing.T) {
+	get := FromValues(url.Values{})
+	called := false
+
+	err := OptEach("tags", Regex(`^[A-Za-z]+$`))(get, func(string, []string) error {
+		called = true
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if called {
+		t.Error("consumer should not be called for a missing key")
+	}
+}
+
+func TestReqN(t *testing.T) {
+	get := FromValues(url.Values{
+		"choice": {"a", "b"},
+	})
+
+	if err := ReqN("choice", 1, 1, OneOf("a", "b", "c"))(get, func(string, []string) error { return nil }); err == nil {
+		t.Error("missing error for wrong value count")
+	}
+
+	if err := ReqN("choice", 2, 3, OneOf("a", "b", "c"))(get, func(string, []string) error { return nil }); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestSingle(t *testing.T) {
+	get := FromValues(url.Values{
+		"aaa": {"zzz", "yyy"},
+	})
+
+	validate := Req("aaa", OneOf("zzz"))
+
+	m, err := validate.Map(Single(get))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m["aaa"] != "zzz" {
+		t.Errorf("unexpected value: %q", m["aaa"])
+	}
+}
@@ -100,7 +100,7 @@ func Req(key string, check Checker) Validator {
 			return doCheck(key, val, check, cons)
 		}
 
-		return errors.New("missing key: " + strconv.Quote(key))
+		return &fieldErr{key, errors.New("parameter " + strconv.Quote(key) + ": missing value")}
 	}
 }
 
@@ -127,7 +127,11 @@ func OptDef(key string, check Checker, deflt string) Validator {
 			return doCheck(key, val, check, cons)
 		}
 
-		return cons(key, deflt)
+		if err := cons(key, deflt); err != nil {
+			return &fieldErr{key, err}
+		}
+
+		return nil
 	}
 }
 
@@ -154,10 +158,30 @@ func Cond(key string, check Checker, yes, no Validator) Validator {
 
 func doCheck(key, val string, check Checker, cons Consumer) (err error) {
 	if val, err = check(val); err != nil {
-		return errors.New("invalid value for key " + strconv.Quote(key) + ": " + err.Error())
+		return &fieldErr{key, errors.New("parameter " + strconv.Quote(key) + ": " + err.Error())}
 	}
 
-	return cons(key, val)
+	if err = cons(key, val); err != nil {
+		return &fieldErr{key, err}
+	}
+
+	return nil
+}
+
+// fieldErr tags an error with the key of the field that caused it, without changing
+// its Error() text. It lets PackAll() recover the offending key via errors.As()
+// while every other code path keeps seeing the original error message.
+type fieldErr struct {
+	key string
+	err error
+}
+
+func (e *fieldErr) Error() string {
+	return e.err.Error()
+}
+
+func (e *fieldErr) Unwrap() error {
+	return e.err
 }
 
 // OneOf constructs a Checker function that attempts to find its argument in the given list of
@@ -179,7 +203,7 @@ func OneOf(literals ...string) Checker {
 
 	return func(val string) (s string, err error) {
 		if s = m[val]; len(s) == 0 {
-			err = errors.New(strconv.Quote(val))
+			err = errors.New("invalid value: " + strconv.Quote(val))
 		}
 
 		return
@@ -195,7 +219,7 @@ func Regex(patt string) Checker {
 			return val, nil
 		}
 
-		return "", errors.New(strconv.Quote(val))
+		return "", errors.New("invalid value: " + strconv.Quote(val))
 	}
 }
 
@@ -218,7 +242,7 @@ func mapNumErr(val string, err error) error {
 		err = e.Err
 	}
 
-	return errors.New(strconv.Quote(val) + ": " + err.Error())
+	return errors.New(err.Error() + ": " + strconv.Quote(val))
 }
 
 // FromMap is a convenience function that constructs a Getter from the given Go map.
@@ -1,6 +1,7 @@
 package vald
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 )
@@ -123,6 +124,89 @@ func TestErrors(t *testing.T) {
 	}
 }
 
+func TestPackAll(t *testing.T) {
+	src := map[string]string{
+		"aaa": "XXX",
+		"bbb": "yyy",
+	}
+
+	validate := PackAll(
+		Req("aaa", OneOf("xxx", "yyy", "zzz")),
+		Req("bbb", Regex(`^[a-z]{3}$`)),
+		Req("ccc", Bool),
+	)
+
+	_, err := validate.Map(FromMap(src))
+
+	if err == nil {
+		t.Fatal("missing error")
+	}
+
+	var errs Errors
+
+	if !errors.As(err, &errs) {
+		t.Fatalf("error is not of type Errors: %v", err)
+	}
+
+	if len(errs) != 2 {
+		t.Fatalf("unexpected number of errors: %d instead of 2", len(errs))
+	}
+
+	if _, ok := errs["aaa"]; !ok {
+		t.Error(`missing error for key "aaa"`)
+	}
+
+	if _, ok := errs["ccc"]; !ok {
+		t.Error(`missing error for key "ccc"`)
+	}
+
+	if _, ok := errs["bbb"]; ok {
+		t.Error(`unexpected error for key "bbb"`)
+	}
+}
+
+func TestPackAllNested(t *testing.T) {
+	src := map[string]string{
+		"bbb": "yyy",
+	}
+
+	validate := PackAll(
+		PackAll(
+			Req("aaa", OneOf("xxx", "yyy", "zzz")),
+			Req("bbb", Regex(`^[a-z]{3}$`)),
+		),
+		Req("ccc", Bool),
+	)
+
+	_, err := validate.Map(FromMap(src))
+
+	if err == nil {
+		t.Fatal("missing error")
+	}
+
+	var errs Errors
+
+	if !errors.As(err, &errs) {
+		t.Fatalf("error is not of type Errors: %v", err)
+	}
+
+	if len(errs) != 2 {
+		t.Fatalf("unexpected number of errors: %d instead of 2: %v", len(errs), errs)
+	}
+
+	if _, ok := errs["aaa"]; !ok {
+		t.Error(`missing error for key "aaa", nested Errors was not flattened`)
+	}
+
+	if _, ok := errs["ccc"]; !ok {
+		t.Error(`missing error for key "ccc"`)
+	}
+
+	if _, ok := errs[""]; ok {
+		t.Error(`nested Errors must not be collapsed under the empty key`)
+	}
+}
+
 func TestCond(t *testing.T) {
 	type pair struct {
 		key, value string